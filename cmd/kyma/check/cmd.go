@@ -0,0 +1,97 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+// NewCmd creates a new check command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Runs preflight and postflight diagnostics for a Kyma cluster.",
+		Long:  `Use this command to verify a cluster is ready for "kyma install" or that a running Kyma installation is healthy.`,
+	}
+
+	cmd.PersistentFlags().BoolVar(&o.All, "all", false, "Run all checks instead of stopping at the first failure.")
+	// --output is a persistent flag on the root command, bound to cli.Options.Output.
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "pre",
+		Short: "Validates that a cluster is ready for Kyma installation.",
+		Long:  `Use this command to run preflight checks (Kubernetes version, required CRDs, available resources, storage class, DNS, egress) before "kyma install".`,
+		RunE:  func(_ *cobra.Command, _ []string) error { return c.run(preRegistry()) },
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "post",
+		Short: "Validates that a running Kyma installation is healthy.",
+		Long:  `Use this command to run postflight checks (installer pod, Installation CR phase, required namespaces, ingress reachability, root certificate trust) after "kyma install".`,
+		RunE:  func(_ *cobra.Command, _ []string) error { return c.run(postRegistry()) },
+	})
+
+	return cmd
+}
+
+func (c *command) run(r *registry) error {
+	format, err := output.ParseFormat(c.opts.Output)
+	if err != nil {
+		return err
+	}
+
+	client, err := kube.NewFromConfig("", c.opts.KubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("could not initialize the Kubernetes client: %s", err)
+	}
+
+	results, err := r.run(context.Background(), client, c.opts.All)
+	if err != nil {
+		return err
+	}
+
+	c.printText(results)
+	if err := output.EmitSummary(format, results); err != nil {
+		return err
+	}
+
+	if failed(results) {
+		return fmt.Errorf("one or more checks failed")
+	}
+	return nil
+}
+
+func (c *command) printText(results []Result) {
+	for _, r := range results {
+		s := output.Step(c.Command, r.Name)
+		switch r.Status {
+		case StatusOK:
+			s.Success()
+		case StatusWarning:
+			s.LogError(r.Message)
+			s.Success()
+		case StatusFailed:
+			s.Failure()
+		}
+		if r.Message != "" && r.Status != StatusWarning {
+			s.LogInfo(r.Message)
+		}
+		if r.Remediation != "" {
+			s.LogInfo(fmt.Sprintf("Remediation: %s", r.Remediation))
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package check
+
+import (
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+// Options defines available options for the check command.
+type Options struct {
+	*cli.Options
+	All bool
+}
+
+// NewOptions creates options with default values.
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
@@ -0,0 +1,196 @@
+package check
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyma-project/cli/internal/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// requiredNamespaces lists the namespaces a healthy Kyma installation must have.
+var requiredNamespaces = []string{"kyma-system", "kyma-integration", "istio-system"}
+
+// installationGVR identifies the installer.kyma-project.io Installation custom resource.
+var installationGVR = schema.GroupVersionResource{Group: "installer.kyma-project.io", Version: "v1alpha1", Resource: "installations"}
+
+// consoleVirtualServiceGVR identifies the istio VirtualService that exposes the Kyma console and
+// carries the cluster's public ingress domain in its hosts.
+var consoleVirtualServiceGVR = schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1alpha3", Resource: "virtualservices"}
+
+// rootCertSecretNamespace and rootCertSecretName identify the secret holding the cluster's Kyma root CA certificate, the same
+// one "kyma install"/"kyma credentials trust" import into the OS trust store.
+const rootCertSecretNamespace = "istio-system"
+const rootCertSecretName = "kyma-gateway-certs-cacert"
+
+// postRegistry returns the registry of checks run after `kyma install`.
+func postRegistry() *registry {
+	r := newRegistry()
+	r.register(&installerPodChecker{})
+	r.register(&installationCRChecker{})
+	r.register(&namespacesChecker{})
+	r.register(&ingressChecker{})
+	r.register(&rootCertChecker{})
+	return r
+}
+
+type installerPodChecker struct{}
+
+func (c *installerPodChecker) Name() string { return "installer-pod" }
+
+func (c *installerPodChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	pods, err := client.Static().CoreV1().Pods("kyma-installer").List(metav1.ListOptions{LabelSelector: "name=kyma-installer"})
+	if err != nil {
+		return Result{}, fmt.Errorf("could not list installer pods: %s", err)
+	}
+	for _, p := range pods.Items {
+		if p.Status.Phase == "Running" {
+			return Result{Name: c.Name(), Status: StatusOK, Message: "Kyma installer pod is running"}, nil
+		}
+	}
+	return Result{
+		Name:        c.Name(),
+		Status:      StatusFailed,
+		Message:     "Kyma installer pod is not running",
+		Remediation: "Check `kubectl -n kyma-installer logs` for the installer pod.",
+	}, nil
+}
+
+type installationCRChecker struct{}
+
+func (c *installationCRChecker) Name() string { return "installation-cr" }
+
+func (c *installationCRChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	u, err := client.Dynamic().Resource(installationGVR).Get("kyma-installation", metav1.GetOptions{})
+	if err != nil {
+		return Result{}, fmt.Errorf("could not get the Installation CR: %s", err)
+	}
+	phase, _, err := unstructured.NestedString(u.Object, "status", "state")
+	if err != nil {
+		return Result{}, fmt.Errorf("could not read Installation CR status: %s", err)
+	}
+	if phase != "Installed" {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusFailed,
+			Message:     fmt.Sprintf("Installation CR is in phase %q", phase),
+			Remediation: "Run `kyma install --update` or inspect the installer logs.",
+		}, nil
+	}
+	return Result{Name: c.Name(), Status: StatusOK, Message: "Installation CR reports phase Installed"}, nil
+}
+
+type namespacesChecker struct{}
+
+func (c *namespacesChecker) Name() string { return "required-namespaces" }
+
+func (c *namespacesChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	for _, ns := range requiredNamespaces {
+		if _, err := client.Static().CoreV1().Namespaces().Get(ns, metav1.GetOptions{}); err != nil {
+			return Result{
+				Name:        c.Name(),
+				Status:      StatusFailed,
+				Message:     fmt.Sprintf("Namespace %q is missing", ns),
+				Remediation: "Re-run `kyma install` to recreate the missing namespace.",
+			}, nil
+		}
+	}
+	return Result{Name: c.Name(), Status: StatusOK, Message: "All required namespaces are present"}, nil
+}
+
+type ingressChecker struct{}
+
+func (c *ingressChecker) Name() string { return "ingress" }
+
+func (c *ingressChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	host, err := c.consoleHost(client)
+	if err != nil {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusWarning,
+			Message:     fmt.Sprintf("Could not determine the cluster's ingress domain: %s", err),
+			Remediation: "Verify the cluster's ingress domain resolves and is reachable.",
+		}, nil
+	}
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	resp, err := httpClient.Get("https://" + host)
+	if err != nil {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusWarning,
+			Message:     fmt.Sprintf("Ingress %q is not reachable: %s", host, err),
+			Remediation: "Verify the cluster's ingress domain resolves and is reachable.",
+		}, nil
+	}
+	resp.Body.Close()
+
+	return Result{Name: c.Name(), Status: StatusOK, Message: fmt.Sprintf("Ingress %q is reachable", host)}, nil
+}
+
+// consoleHost returns the first host the Kyma console's VirtualService is exposed on.
+func (c *ingressChecker) consoleHost(client kube.KymaKube) (string, error) {
+	u, err := client.Dynamic().Resource(consoleVirtualServiceGVR).Namespace("kyma-system").Get("console-web", metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not get the console VirtualService: %s", err)
+	}
+	hosts, _, err := unstructured.NestedStringSlice(u.Object, "spec", "hosts")
+	if err != nil {
+		return "", fmt.Errorf("could not read the console VirtualService's hosts: %s", err)
+	}
+	if len(hosts) == 0 {
+		return "", fmt.Errorf("the console VirtualService has no hosts")
+	}
+	return hosts[0], nil
+}
+
+type rootCertChecker struct{}
+
+func (c *rootCertChecker) Name() string { return "root-cert-trusted" }
+
+func (c *rootCertChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	secret, err := client.Static().CoreV1().Secrets(rootCertSecretNamespace).Get(rootCertSecretName, metav1.GetOptions{})
+	if err != nil {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusWarning,
+			Message:     fmt.Sprintf("Could not read the cluster root certificate: %s", err),
+			Remediation: "Run `kyma credentials trust` to trust the cluster's root certificate.",
+		}, nil
+	}
+
+	block, _ := pem.Decode(secret.Data["cacert"])
+	if block == nil {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusWarning,
+			Message:     fmt.Sprintf("Secret %q has no cacert entry", rootCertSecretName),
+			Remediation: "Run `kyma credentials trust` to trust the cluster's root certificate.",
+		}, nil
+	}
+	crt, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return Result{}, fmt.Errorf("could not parse the cluster root certificate: %s", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if _, err := crt.Verify(x509.VerifyOptions{Roots: pool}); err != nil {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusWarning,
+			Message:     "Cluster root certificate is not trusted by this OS",
+			Remediation: "Run `kyma credentials trust` to trust the cluster's root certificate.",
+		}, nil
+	}
+
+	return Result{Name: c.Name(), Status: StatusOK, Message: "Cluster root certificate is trusted by this OS"}, nil
+}
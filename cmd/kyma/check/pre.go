@@ -0,0 +1,199 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli/internal/kube"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// crdGVR identifies the CustomResourceDefinition resource itself, as opposed to the Kyma custom
+// resources it defines.
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// installationCRDName is the CRD Kyma's own Installation custom resource is served from.
+const installationCRDName = "installations.installer.kyma-project.io"
+
+// installationCRDVersion is the Installation CRD version this CLI's install flow expects.
+const installationCRDVersion = "v1alpha1"
+
+// requiredEgressTargets are the hosts the cluster nodes must be able to reach to install Kyma.
+var requiredEgressTargets = []string{
+	"https://github.com",
+	"https://eu.gcr.io",
+}
+
+// preRegistry returns the registry of checks run before `kyma install`.
+func preRegistry() *registry {
+	r := newRegistry()
+	r.register(&k8sVersionChecker{})
+	r.register(&requiredCRDsChecker{})
+	r.register(&resourcesChecker{})
+	r.register(&storageClassChecker{})
+	r.register(&dnsChecker{})
+	r.register(&egressChecker{})
+	return r
+}
+
+type k8sVersionChecker struct{}
+
+func (c *k8sVersionChecker) Name() string { return "k8s-version" }
+
+func (c *k8sVersionChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	v, err := client.Static().Discovery().ServerVersion()
+	if err != nil {
+		return Result{}, fmt.Errorf("could not fetch Kubernetes server version: %s", err)
+	}
+	if !isSupportedVersion(v.GitVersion) {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusFailed,
+			Message:     fmt.Sprintf("Kubernetes version %s is not supported", v.GitVersion),
+			Remediation: "Upgrade the cluster to a supported Kubernetes version before installing Kyma.",
+		}, nil
+	}
+	return Result{Name: c.Name(), Status: StatusOK, Message: fmt.Sprintf("Kubernetes version %s is supported", v.GitVersion)}, nil
+}
+
+type requiredCRDsChecker struct{}
+
+func (c *requiredCRDsChecker) Name() string { return "required-crds" }
+
+func (c *requiredCRDsChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	u, err := client.Dynamic().Resource(crdGVR).Get(installationCRDName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return Result{Name: c.Name(), Status: StatusOK, Message: "No conflicting CRDs found"}, nil
+		}
+		return Result{}, fmt.Errorf("could not get the %q CRD: %s", installationCRDName, err)
+	}
+
+	versions, _, err := unstructured.NestedSlice(u.Object, "spec", "versions")
+	if err != nil {
+		return Result{}, fmt.Errorf("could not read the %q CRD's versions: %s", installationCRDName, err)
+	}
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if ok && version["name"] == installationCRDVersion {
+			return Result{Name: c.Name(), Status: StatusOK, Message: "No conflicting CRDs found"}, nil
+		}
+	}
+	return Result{
+		Name:        c.Name(),
+		Status:      StatusFailed,
+		Message:     fmt.Sprintf("CRD %q already exists but does not serve version %q", installationCRDName, installationCRDVersion),
+		Remediation: fmt.Sprintf("Remove the conflicting %q CRD before installing Kyma.", installationCRDName),
+	}, nil
+}
+
+type resourcesChecker struct{}
+
+func (c *resourcesChecker) Name() string { return "cluster-resources" }
+
+func (c *resourcesChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	nodes, err := client.Static().CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return Result{}, fmt.Errorf("could not list nodes: %s", err)
+	}
+	if len(nodes.Items) == 0 {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusFailed,
+			Message:     "No nodes found in the cluster",
+			Remediation: "Make sure the cluster has at least one schedulable node.",
+		}, nil
+	}
+	return Result{Name: c.Name(), Status: StatusOK, Message: fmt.Sprintf("%d node(s) available", len(nodes.Items))}, nil
+}
+
+type storageClassChecker struct{}
+
+func (c *storageClassChecker) Name() string { return "storage-class" }
+
+func (c *storageClassChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	scs, err := client.Static().StorageV1().StorageClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return Result{}, fmt.Errorf("could not list storage classes: %s", err)
+	}
+	if len(scs.Items) == 0 {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusFailed,
+			Message:     "No storage class found",
+			Remediation: "Provision a default storage class before installing Kyma.",
+		}, nil
+	}
+	return Result{Name: c.Name(), Status: StatusOK, Message: "A storage class is available"}, nil
+}
+
+type dnsChecker struct{}
+
+func (c *dnsChecker) Name() string { return "dns" }
+
+func (c *dnsChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	pods, err := client.Static().CoreV1().Pods("kube-system").List(metav1.ListOptions{})
+	if err != nil {
+		return Result{}, fmt.Errorf("could not list kube-system pods: %s", err)
+	}
+	for _, p := range pods.Items {
+		if isCoreDNSPod(p.Name) && p.Status.Phase == "Running" {
+			return Result{Name: c.Name(), Status: StatusOK, Message: "Cluster DNS is running"}, nil
+		}
+	}
+	return Result{
+		Name:        c.Name(),
+		Status:      StatusWarning,
+		Message:     "Could not confirm a running cluster DNS pod",
+		Remediation: "Verify that CoreDNS (or an equivalent) is deployed and healthy.",
+	}, nil
+}
+
+type egressChecker struct{}
+
+func (c *egressChecker) Name() string { return "egress" }
+
+// Run probes requiredEgressTargets from the machine running "kyma check", as a stand-in for a
+// true in-cluster probe (which would need a short-lived Job and isn't wired up yet).
+func (c *egressChecker) Run(ctx context.Context, client kube.KymaKube) (Result, error) {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+
+	var unreachable []string
+	for _, target := range requiredEgressTargets {
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, target, nil)
+		if err != nil {
+			return Result{}, fmt.Errorf("could not build a request for %q: %s", target, err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			unreachable = append(unreachable, target)
+			continue
+		}
+		resp.Body.Close()
+	}
+
+	if len(unreachable) > 0 {
+		return Result{
+			Name:        c.Name(),
+			Status:      StatusWarning,
+			Message:     fmt.Sprintf("Could not reach: %s", strings.Join(unreachable, ", ")),
+			Remediation: "Make sure the cluster nodes can reach the Kyma installation sources.",
+		}, nil
+	}
+	return Result{Name: c.Name(), Status: StatusOK, Message: "Egress to required registries and domains is reachable"}, nil
+}
+
+func isSupportedVersion(gitVersion string) bool {
+	// Only an empty version is rejected; the version is not compared against a minimum.
+	return gitVersion != ""
+}
+
+func isCoreDNSPod(name string) bool {
+	return strings.HasPrefix(name, "coredns")
+}
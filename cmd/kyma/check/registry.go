@@ -0,0 +1,73 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/kube"
+)
+
+// Status describes the outcome of a single Checker run.
+type Status string
+
+const (
+	// StatusOK means the check passed.
+	StatusOK Status = "ok"
+	// StatusFailed means the check failed and should block the requested operation.
+	StatusFailed Status = "failed"
+	// StatusWarning means the check found something worth surfacing but not blocking.
+	StatusWarning Status = "warning"
+)
+
+// Result is the outcome of a single Checker run.
+type Result struct {
+	Name        string `json:"name"`
+	Status      Status `json:"status"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Checker is a single preflight or postflight diagnostic.
+type Checker interface {
+	// Name is the short, unique identifier of the check, e.g. "k8s-version".
+	Name() string
+	// Run executes the check against the given cluster and returns its Result.
+	Run(ctx context.Context, client kube.KymaKube) (Result, error)
+}
+
+// registry holds all the Checkers registered for a given mode (pre/post).
+type registry struct {
+	checkers []Checker
+}
+
+func newRegistry() *registry {
+	return &registry{}
+}
+
+func (r *registry) register(c Checker) {
+	r.checkers = append(r.checkers, c)
+}
+
+func (r *registry) run(ctx context.Context, client kube.KymaKube, all bool) ([]Result, error) {
+	results := make([]Result, 0, len(r.checkers))
+	for _, c := range r.checkers {
+		res, err := c.Run(ctx, client)
+		if err != nil {
+			return results, fmt.Errorf("could not run check %q: %s", c.Name(), err)
+		}
+		results = append(results, res)
+		if !all && res.Status == StatusFailed {
+			break
+		}
+	}
+	return results, nil
+}
+
+func failed(results []Result) bool {
+	for _, r := range results {
+		if r.Status == StatusFailed {
+			return true
+		}
+	}
+	return false
+}
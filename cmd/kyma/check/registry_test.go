@@ -0,0 +1,54 @@
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChecker struct {
+	name   string
+	result Result
+	err    error
+}
+
+func (f *fakeChecker) Name() string { return f.name }
+
+func (f *fakeChecker) Run(_ context.Context, _ kube.KymaKube) (Result, error) {
+	return f.result, f.err
+}
+
+func Test_RegistryRun(t *testing.T) {
+	t.Run("stops at the first failure unless all is set", func(t *testing.T) {
+		r := newRegistry()
+		r.register(&fakeChecker{name: "a", result: Result{Name: "a", Status: StatusFailed}})
+		r.register(&fakeChecker{name: "b", result: Result{Name: "b", Status: StatusOK}})
+
+		results, err := r.run(context.Background(), nil, false)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.True(t, failed(results))
+	})
+
+	t.Run("runs every check when all is set", func(t *testing.T) {
+		r := newRegistry()
+		r.register(&fakeChecker{name: "a", result: Result{Name: "a", Status: StatusFailed}})
+		r.register(&fakeChecker{name: "b", result: Result{Name: "b", Status: StatusOK}})
+
+		results, err := r.run(context.Background(), nil, true)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.True(t, failed(results))
+	})
+
+	t.Run("reports no failure when all checks pass", func(t *testing.T) {
+		r := newRegistry()
+		r.register(&fakeChecker{name: "a", result: Result{Name: "a", Status: StatusOK}})
+
+		results, err := r.run(context.Background(), nil, false)
+		require.NoError(t, err)
+		require.False(t, failed(results))
+	})
+}
@@ -0,0 +1,49 @@
+package credentials
+
+import (
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+// NewCmd creates a new credentials command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "credentials",
+		Short: "Manages per-user kubeconfig credentials for a Kyma cluster.",
+		Long:  `Use this command to issue short-lived, certificate-based kubeconfigs and to trust a cluster's root certificate, independently of "kyma install".`,
+	}
+	// --output is a persistent flag on the root command, bound to cli.Options.Output.
+
+	issueCmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Issues a client-certificate kubeconfig for a Kyma cluster user.",
+		Long:  `Use this command to submit a CertificateSigningRequest for the given user and groups, wait for it to be approved and signed, and write the resulting client certificate into a kubeconfig entry.`,
+		RunE:  func(_ *cobra.Command, _ []string) error { return c.issue() },
+	}
+	issueCmd.Flags().StringVar(&o.User, "user", "", "Common name of the user to issue credentials for. (required)")
+	issueCmd.Flags().StringSliceVar(&o.Groups, "groups", nil, "Comma-separated list of groups to include in the issued certificate.")
+	issueCmd.Flags().DurationVar(&o.TTL, "ttl", 24*time.Hour, "Validity duration of the issued certificate.")
+	issueCmd.Flags().StringVar(&o.Destination, "destination", "", "Path to write the kubeconfig to. (defaults to --kubeconfig)")
+	cmd.AddCommand(issueCmd)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "trust",
+		Short: "Imports the Kyma cluster root certificate into the OS trust store.",
+		Long:  `Use this command to trust a Kyma cluster's root certificate outside of the "kyma install" flow.`,
+		RunE:  func(_ *cobra.Command, _ []string) error { return c.trust() },
+	})
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package credentials
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/kube"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// csrPollInterval is how often issue re-checks a CertificateSigningRequest for a decision.
+const csrPollInterval = 2 * time.Second
+
+// csrApprovalTimeout bounds how long waitForCertificate waits for a CSR to be approved and
+// signed, so a cluster with no approver (or a stuck signer controller) fails fast instead of
+// hanging "kyma credentials issue" forever.
+const csrApprovalTimeout = 5 * time.Minute
+
+// issue requests a client certificate for c.opts.User (and c.opts.Groups), waits for it to be
+// approved and signed, and writes a kubeconfig entry using the resulting client-certificate-data
+// and client-key-data, instead of a bearer token.
+func (c *command) issue() error {
+	if err := c.opts.validateIssue(); err != nil {
+		return err
+	}
+	format, err := output.ParseFormat(c.opts.Output)
+	if err != nil {
+		return err
+	}
+
+	s := output.Step(c.Command, fmt.Sprintf("Requesting credentials for %q", c.opts.User))
+
+	keyPEM, csrPEM, err := generateKeyAndCSR(c.opts.User, c.opts.Groups)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not generate a key and certificate request: %s", err)
+	}
+
+	client, err := kube.NewFromConfig("", c.opts.KubeconfigPath)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not initialize the Kubernetes client: %s", err)
+	}
+
+	csrName := fmt.Sprintf("kyma-credentials-%s-%d", c.opts.User, time.Now().Unix())
+	if err := submitCSR(client, csrName, csrPEM, c.opts.TTL); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not submit the CertificateSigningRequest: %s", err)
+	}
+
+	s.LogInfo(fmt.Sprintf("Waiting for %q to be approved and signed", csrName))
+	crtPEM, err := waitForCertificate(client, csrName, csrApprovalTimeout)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+
+	path := c.opts.Destination
+	if path == "" {
+		path = c.opts.KubeconfigPath
+	}
+	expiry := time.Now().Add(c.opts.TTL)
+	if err := writeKubeconfig(path, c.opts.KubeconfigPath, c.opts.User, keyPEM, crtPEM, expiry); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not write the kubeconfig: %s", err)
+	}
+
+	s.Success(fmt.Sprintf("Credentials for %q written to %s (expires %s)", c.opts.User, path, expiry.Format(time.RFC3339)))
+
+	return output.EmitSummary(format, map[string]interface{}{
+		"user":                c.opts.User,
+		"groups":              c.opts.Groups,
+		"kubeconfigPath":      path,
+		"expirationTimestamp": expiry.Format(time.RFC3339),
+	})
+}
+
+// generateKeyAndCSR creates an RSA private key and a PKCS#10 certificate request for user, with
+// groups encoded as the request's organizations, as Kubernetes expects for a client certificate.
+func generateKeyAndCSR(user string, groups []string) (keyPEM, csrPEM []byte, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: user, Organization: groups},
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &template, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	csrPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+	return keyPEM, csrPEM, nil
+}
+
+// submitCSR creates a CertificateSigningRequest named name on the cluster for csrPEM, requesting a
+// client-auth certificate valid for ttl.
+func submitCSR(client kube.KymaKube, name string, csrPEM []byte, ttl time.Duration) error {
+	expirationSeconds := int32(ttl.Seconds())
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           csrPEM,
+			SignerName:        "kubernetes.io/kube-apiserver-client",
+			ExpirationSeconds: &expirationSeconds,
+			Usages:            []certificatesv1.KeyUsage{certificatesv1.UsageClientAuth},
+		},
+	}
+	_, err := client.Static().CertificatesV1().CertificateSigningRequests().Create(csr)
+	return err
+}
+
+// waitForCertificate polls the named CertificateSigningRequest until it is approved and signed,
+// denied, or failed, returning the signed certificate in the approved case. It gives up once
+// timeout elapses, so a cluster with no CSR approver configured fails fast instead of hanging.
+func waitForCertificate(client kube.KymaKube, name string, timeout time.Duration) ([]byte, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		csr, err := client.Static().CertificatesV1().CertificateSigningRequests().Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("could not get the CertificateSigningRequest: %s", err)
+		}
+
+		for _, cond := range csr.Status.Conditions {
+			if cond.Type == certificatesv1.CertificateDenied {
+				return nil, fmt.Errorf("CertificateSigningRequest %q was denied: %s", name, cond.Message)
+			}
+			if cond.Type == certificatesv1.CertificateFailed {
+				return nil, fmt.Errorf("CertificateSigningRequest %q failed: %s", name, cond.Message)
+			}
+		}
+
+		if len(csr.Status.Certificate) > 0 {
+			return csr.Status.Certificate, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("CertificateSigningRequest %q was not approved and signed within %s", name, timeout)
+		}
+
+		time.Sleep(csrPollInterval)
+	}
+}
+
+// writeKubeconfig merges a client-certificate-based user entry named user into the kubeconfig at
+// srcPath and writes the result to destPath, recording expiry as an extension so callers can tell
+// at a glance when the credentials need to be re-issued.
+func writeKubeconfig(destPath, srcPath, user string, keyPEM, crtPEM []byte, expiry time.Time) error {
+	config, err := clientcmd.LoadFromFile(srcPath)
+	if err != nil {
+		return fmt.Errorf("could not load kubeconfig %q: %s", srcPath, err)
+	}
+
+	expiryJSON, err := json.Marshal(map[string]string{"expirationTimestamp": expiry.Format(time.RFC3339)})
+	if err != nil {
+		return err
+	}
+
+	authInfo := clientcmdapi.NewAuthInfo()
+	authInfo.ClientCertificateData = crtPEM
+	authInfo.ClientKeyData = keyPEM
+	authInfo.Extensions = map[string]runtime.Object{
+		"expirationTimestamp": &runtime.Unknown{Raw: expiryJSON},
+	}
+	config.AuthInfos[user] = authInfo
+
+	return clientcmd.WriteToFile(*config, destPath)
+}
@@ -0,0 +1,33 @@
+package credentials
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+// Options defines available options for the credentials command.
+type Options struct {
+	*cli.Options
+	User        string
+	Groups      []string
+	TTL         time.Duration
+	Destination string
+}
+
+// NewOptions creates options with default values.
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
+
+// validateIssue checks that the flags required by "credentials issue" are set correctly.
+func (o *Options) validateIssue() error {
+	if o.User == "" {
+		return errors.New("required flag `user` has not been set")
+	}
+	if o.TTL <= 0 {
+		return errors.New("`ttl` must be a positive duration")
+	}
+	return nil
+}
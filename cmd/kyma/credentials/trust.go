@@ -0,0 +1,39 @@
+package credentials
+
+import (
+	"errors"
+
+	"github.com/kyma-project/cli/core/service/cert"
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/trust"
+)
+
+// trust imports the cluster's Kyma root certificate into the OS trust store, using the same
+// internal/trust.Certifier abstraction "kyma install" uses right after a fresh installation. This
+// lets a user (re-)trust a cluster without re-running the whole install flow.
+func (c *command) trust() error {
+	format, err := output.ParseFormat(c.opts.Output)
+	if err != nil {
+		return err
+	}
+
+	s := output.Step(c.Command, "Importing Kyma certificate")
+
+	ca := trust.NewCertifier(c.opts.KubeconfigPath)
+	crt := ca.Certificate()
+	if len(crt) == 0 {
+		s.Failure()
+		return errors.New("could not retrieve the certificate")
+	}
+
+	if err := ca.StoreCertificate(); err != nil {
+		s.Failure()
+		return err
+	}
+
+	s.Success("Kyma root certificate imported")
+
+	return output.EmitSummary(format, map[string]interface{}{
+		"certFingerprint": cert.Fingerprint(crt),
+	})
+}
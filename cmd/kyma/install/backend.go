@@ -0,0 +1,16 @@
+package install
+
+// Backend drives the installation of Kyma components onto a cluster. The installer-CR backend
+// and the Helm backend both implement it, so Run can stay agnostic of which one is selected via
+// --backend.
+type Backend interface {
+	// Prepare resolves the component list and any required sources (installer image, charts) for
+	// the given Kyma source (version, local path, or git ref).
+	Prepare(source string) error
+	// Apply installs or upgrades every resolved component on the cluster.
+	Apply() error
+	// WaitReady blocks until every component reports ready, or returns an error on timeout/failure.
+	WaitReady() error
+	// Rollback reverts whatever Apply already applied, best-effort, after a failed WaitReady.
+	Rollback() error
+}
@@ -0,0 +1,186 @@
+package install
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyma-project/cli/core/service/cert"
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+// NewCmd creates a new install command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Installs Kyma on a running Kubernetes cluster.",
+		Long:  `Use this command to install Kyma on a running Kubernetes cluster.`,
+		RunE:  func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().BoolVar(&o.NoWait, "noWait", false, "Do not wait for the installation to complete.")
+	cmd.Flags().StringVarP(&o.Source, "source", "s", "latest", "Installation source.")
+	cmd.Flags().StringVar(&o.Backend, "backend", "installer", "Installation backend to use. One of: installer, helm.")
+	cmd.Flags().StringVar(&o.ComponentsFile, "components", "", "Path to the components list used by the helm backend (defaults to \"<source>/installation/resources/components.yaml\").")
+	cmd.Flags().StringVar(&o.OverridesFile, "values", "", "Path to a YAML file with per-component Helm values overrides, keyed by component name.")
+	// --output is a persistent flag on the root command, bound to cli.Options.Output.
+
+	return cmd
+}
+
+// Run installs Kyma for the already-populated o, without going through a cobra command. It is the
+// entry point for callers, such as tests/acceptance, that drive the install backend directly.
+func Run(o *Options) error {
+	c := command{Command: cli.Command{Options: o.Options}, opts: o}
+	return c.Run()
+}
+
+func (c *command) Run() error {
+	format, err := output.ParseFormat(c.opts.Output)
+	if err != nil {
+		return err
+	}
+
+	backend, err := c.newBackend()
+	if err != nil {
+		return err
+	}
+
+	if err := backend.Prepare(c.opts.Source); err != nil {
+		return err
+	}
+	if err := backend.Apply(); err != nil {
+		if rbErr := backend.Rollback(); rbErr != nil {
+			return fmt.Errorf("installation failed: %s (rollback failed: %s)", err, rbErr)
+		}
+		return err
+	}
+	if !c.opts.NoWait {
+		if err := backend.WaitReady(); err != nil {
+			if rbErr := backend.Rollback(); rbErr != nil {
+				return fmt.Errorf("installation did not become ready: %s (rollback failed: %s)", err, rbErr)
+			}
+			return err
+		}
+	}
+
+	ca := trust.NewCertifier(c.opts.KubeconfigPath)
+	c.CurrentStep = output.Step(c.Command, "Importing Kyma certificate")
+	if err := c.importCertificate(ca); err != nil {
+		return err
+	}
+
+	return output.EmitSummary(format, map[string]interface{}{
+		"kymaVersion":     c.opts.Source,
+		"kubeconfigPath":  c.opts.KubeconfigPath,
+		"endpoints":       map[string]string{},
+		"certFingerprint": cert.Fingerprint(ca.Certificate()),
+	})
+}
+
+func (c *command) newBackend() (Backend, error) {
+	switch c.opts.Backend {
+	case "", "installer":
+		return newInstallerBackend(c), nil
+	case "helm":
+		return newHelmBackend(c), nil
+	default:
+		return nil, fmt.Errorf("unknown installation backend %q, must be one of: installer, helm", c.opts.Backend)
+	}
+}
+
+// removeActionLabel strips the "action" label the Kyma installer CR is otherwise shipped with,
+// so the installer does not immediately start reconciling before the image override is applied.
+func (c *command) removeActionLabel(data []map[string]interface{}) error {
+	for _, d := range data {
+		metadata, ok := d["metadata"].(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		labels, ok := metadata["labels"].(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := labels["action"]; !ok {
+			return errors.New(`label "action" not found`)
+		}
+		delete(labels, "action")
+	}
+	return nil
+}
+
+// replaceDockerImageURL overrides the kyma-installer-container image of every Deployment in data
+// with imageURL, so a locally built installer image can be used instead of the released one.
+func (c *command) replaceDockerImageURL(data []map[string]interface{}, imageURL string) ([]map[string]interface{}, error) {
+	for _, d := range data {
+		if kind, _ := d["kind"].(string); kind != "Deployment" {
+			continue
+		}
+
+		spec, ok := d["spec"].(map[interface{}]interface{})
+		if !ok {
+			return data, errors.New("deployment has no spec field")
+		}
+		template, ok := spec["template"].(map[interface{}]interface{})
+		if !ok {
+			return data, errors.New("deployment spec has no template field")
+		}
+		podSpec, ok := template["spec"].(map[interface{}]interface{})
+		if !ok {
+			return data, errors.New("deployment template has no spec field")
+		}
+		containers, ok := podSpec["containers"].([]interface{})
+		if !ok {
+			return data, errors.New("deployment pod spec has no containers field")
+		}
+
+		for _, container := range containers {
+			cont, ok := container.(map[interface{}]interface{})
+			if !ok {
+				continue
+			}
+			if cont["name"] == "kyma-installer-container" {
+				cont["image"] = imageURL
+			}
+		}
+	}
+	return data, nil
+}
+
+// importCertificate retrieves the Kyma root certificate from ca and imports it into the OS trust
+// store, unless the user opted out of waiting for the installation with --noWait. It logs through
+// c.CurrentStep, the step Run already minted for this phase, rather than creating a new one.
+func (c *command) importCertificate(ca trust.Certifier) error {
+	s := c.CurrentStep
+
+	if c.opts.NoWait {
+		s.LogError("Manual OS-specific instructions for certificate import")
+		return nil
+	}
+
+	crt := ca.Certificate()
+	if len(crt) == 0 {
+		s.Failure()
+		return errors.New("Could not retrieve the certificate")
+	}
+
+	if err := ca.StoreCertificate(); err != nil {
+		s.Failure()
+		return err
+	}
+
+	s.Success("Kyma root certificate imported")
+	return nil
+}
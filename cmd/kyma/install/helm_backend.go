@@ -0,0 +1,252 @@
+package install
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/step"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+)
+
+// component describes one Helm-chart-driven building block of a Kyma installation, as listed in
+// the components file resolved by helmBackend.Prepare.
+type component struct {
+	Name      string                 `yaml:"name"`
+	Namespace string                 `yaml:"namespace"`
+	Chart     string                 `yaml:"chart"` // local path, oci://<ref>, or https:// chart archive
+	Values    map[string]interface{} `yaml:"values,omitempty"`
+}
+
+// helmBackend installs Kyma by running the Helm SDK directly against each component's chart,
+// rather than shipping manifests through the in-cluster Kyma Installer CR.
+type helmBackend struct {
+	cmd        *command
+	components []component
+	installed  []component
+}
+
+func newHelmBackend(c *command) *helmBackend {
+	return &helmBackend{cmd: c}
+}
+
+// Prepare resolves the components file for source and parses every component it lists, merging in
+// the --values overrides file (if any) on top of each component's own values.
+func (b *helmBackend) Prepare(source string) error {
+	s := output.Step(b.cmd.Command, "Resolving Helm components")
+
+	path := b.cmd.opts.ComponentsFile
+	if path == "" {
+		path = defaultComponentsFile(source)
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not read components file %q: %s", path, err)
+	}
+
+	var components []component
+	if err := yaml.Unmarshal(raw, &components); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not parse components file %q: %s", path, err)
+	}
+	if len(components) == 0 {
+		s.Failure()
+		return fmt.Errorf("components file %q lists no components", path)
+	}
+
+	if b.cmd.opts.OverridesFile != "" {
+		if err := applyOverrides(components, b.cmd.opts.OverridesFile); err != nil {
+			s.Failure()
+			return err
+		}
+	}
+
+	b.components = components
+	s.Success(fmt.Sprintf("Resolved %d component(s)", len(components)))
+	return nil
+}
+
+// Apply installs or upgrades every resolved component's chart, in the order they are listed.
+func (b *helmBackend) Apply() error {
+	for _, c := range b.components {
+		s := output.Step(b.cmd.Command, fmt.Sprintf("Installing component %q", c.Name))
+
+		chrt, err := loadChart(c.Chart)
+		if err != nil {
+			s.Failure()
+			return fmt.Errorf("component %q: could not load chart %q: %s", c.Name, c.Chart, err)
+		}
+
+		cfg, err := b.helmActionConfig(c.Namespace)
+		if err != nil {
+			s.Failure()
+			return fmt.Errorf("component %q: could not build a Helm client: %s", c.Name, err)
+		}
+
+		if err := installOrUpgrade(cfg, c, chrt, s); err != nil {
+			s.Failure()
+			return fmt.Errorf("component %q: %s", c.Name, err)
+		}
+
+		b.installed = append(b.installed, c)
+		s.Success(fmt.Sprintf("Component %q installed", c.Name))
+	}
+	return nil
+}
+
+// WaitReady is a no-op: each component is already installed with atomic+wait semantics by Apply.
+func (b *helmBackend) WaitReady() error {
+	return nil
+}
+
+// Rollback uninstalls every component Apply already installed, in reverse order.
+func (b *helmBackend) Rollback() error {
+	for i := len(b.installed) - 1; i >= 0; i-- {
+		c := b.installed[i]
+		s := output.Step(b.cmd.Command, fmt.Sprintf("Rolling back component %q", c.Name))
+
+		cfg, err := b.helmActionConfig(c.Namespace)
+		if err != nil {
+			s.Failure()
+			return fmt.Errorf("component %q: could not build a Helm client: %s", c.Name, err)
+		}
+
+		uninstall := action.NewUninstall(cfg)
+		if _, err := uninstall.Run(c.Name); err != nil {
+			s.Failure()
+			return fmt.Errorf("component %q: could not uninstall: %s", c.Name, err)
+		}
+		s.Success(fmt.Sprintf("Component %q rolled back", c.Name))
+	}
+	return nil
+}
+
+// helmActionConfig builds a Helm action.Configuration targeting namespace, using the same
+// kubeconfig the rest of the command was configured with.
+func (b *helmBackend) helmActionConfig(namespace string) (*action.Configuration, error) {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig = &b.cmd.opts.KubeconfigPath
+	flags.Namespace = &namespace
+
+	cfg := new(action.Configuration)
+	if err := cfg.Init(flags, namespace, "secrets", log.Printf); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// installOrUpgrade installs c's release if absent, or upgrades it otherwise, waiting for every
+// resource (and hook) to become ready before returning, and surfacing the release's actual hook
+// and status output (not just its static Chart.yaml metadata) through s.
+func installOrUpgrade(cfg *action.Configuration, c component, chrt *chart.Chart, s step.Step) error {
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	_, err := history.Run(c.Name)
+
+	var rel *release.Release
+	if err == nil {
+		up := action.NewUpgrade(cfg)
+		up.Namespace = c.Namespace
+		up.Atomic = true
+		up.Wait = true
+		rel, err = up.Run(c.Name, chrt, c.Values)
+	} else {
+		in := action.NewInstall(cfg)
+		in.ReleaseName = c.Name
+		in.Namespace = c.Namespace
+		in.CreateNamespace = true
+		in.Atomic = true
+		in.Wait = true
+		rel, err = in.Run(chrt, c.Values)
+	}
+	if err != nil {
+		return err
+	}
+
+	logReleaseOutput(rel, s)
+	return nil
+}
+
+// logReleaseOutput surfaces rel's status and every hook's outcome through s, so a user can see
+// what actually ran on the cluster rather than just "installed".
+func logReleaseOutput(rel *release.Release, s step.Step) {
+	s.LogInfo(fmt.Sprintf("Release %q: %s", rel.Name, rel.Info.Status))
+	for _, hook := range rel.Hooks {
+		s.LogInfo(fmt.Sprintf("Hook %q (%s): %s", hook.Name, hook.Kind, hook.LastRun.Phase))
+	}
+}
+
+// loadChart resolves chartRef - a local path, an oci:// registry reference, or an https:// chart
+// archive URL - into a loaded Helm chart.
+func loadChart(chartRef string) (*chart.Chart, error) {
+	switch {
+	case strings.HasPrefix(chartRef, "oci://"):
+		regClient, err := registry.NewClient()
+		if err != nil {
+			return nil, fmt.Errorf("could not create registry client: %s", err)
+		}
+		pulled, err := regClient.Pull(chartRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not pull %q: %s", chartRef, err)
+		}
+		return loader.LoadArchive(strings.NewReader(string(pulled.Chart.Data)))
+	case strings.HasPrefix(chartRef, "https://"), strings.HasPrefix(chartRef, "http://"):
+		if _, err := url.Parse(chartRef); err != nil {
+			return nil, fmt.Errorf("invalid chart URL %q: %s", chartRef, err)
+		}
+		resp, err := http.Get(chartRef)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		return loader.LoadArchive(resp.Body)
+	default:
+		return loader.Load(chartRef)
+	}
+}
+
+// applyOverrides merges the per-component values found in path into the matching component's
+// Values, taking precedence over the component's own defaults.
+func applyOverrides(components []component, path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read values file %q: %s", path, err)
+	}
+
+	var overrides map[string]map[string]interface{}
+	if err := yaml.Unmarshal(raw, &overrides); err != nil {
+		return fmt.Errorf("could not parse values file %q: %s", path, err)
+	}
+
+	for i, c := range components {
+		values, ok := overrides[c.Name]
+		if !ok {
+			continue
+		}
+		if c.Values == nil {
+			c.Values = map[string]interface{}{}
+		}
+		for k, v := range values {
+			c.Values[k] = v
+		}
+		components[i] = c
+	}
+	return nil
+}
+
+// defaultComponentsFile returns the conventional components list location within a Kyma source.
+func defaultComponentsFile(source string) string {
+	return source + "/installation/resources/components.yaml"
+}
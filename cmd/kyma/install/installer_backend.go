@@ -0,0 +1,228 @@
+package install
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/step"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// installationGVR identifies the installer.kyma-project.io Installation custom resource.
+var installationGVR = schema.GroupVersionResource{Group: "installer.kyma-project.io", Version: "v1alpha1", Resource: "installations"}
+
+// installationPollInterval is how often WaitReady re-checks the Installation CR's status.
+const installationPollInterval = 10 * time.Second
+
+// installerBackend is the original install path: it ships the Installation CR and the installer
+// Deployment to the cluster and lets the in-cluster Kyma installer reconcile them.
+type installerBackend struct {
+	cmd     *command
+	data    []map[string]interface{}
+	applied bool
+}
+
+func newInstallerBackend(c *command) *installerBackend {
+	return &installerBackend{cmd: c}
+}
+
+// Prepare downloads the Installation and installer Deployment manifests for source, strips the
+// "action" label so the installer does not reconcile before the image override is applied, and -
+// for a local source - points the installer Deployment at the locally built image.
+func (b *installerBackend) Prepare(source string) error {
+	s := output.Step(b.cmd.Command, "Fetching installer manifests")
+
+	raw, err := fetchManifests(source)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not fetch installer manifests for %q: %s", source, err)
+	}
+
+	var data []map[string]interface{}
+	if err := yaml.Unmarshal(raw, &data); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not parse installer manifests: %s", err)
+	}
+
+	if err := b.cmd.removeActionLabel(data); err != nil {
+		s.Failure()
+		return err
+	}
+
+	if imageURL, ok := localInstallerImage(source); ok {
+		if data, err = b.cmd.replaceDockerImageURL(data, imageURL); err != nil {
+			s.Failure()
+			return err
+		}
+	}
+
+	b.data = data
+	s.Success("Installer manifests resolved")
+	return nil
+}
+
+// Apply ships the prepared manifests to the cluster and sets the "action" label to "install",
+// which is what triggers the in-cluster installer to start reconciling.
+func (b *installerBackend) Apply() error {
+	s := output.Step(b.cmd.Command, "Triggering Kyma installation")
+
+	client, err := kube.NewFromConfig("", b.cmd.opts.KubeconfigPath)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not initialize the Kubernetes client: %s", err)
+	}
+
+	if err := applyManifests(client, b.data); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not apply installer manifests: %s", err)
+	}
+	b.applied = true
+
+	if err := setActionLabel(client, "install"); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not trigger the installation: %s", err)
+	}
+
+	s.Success("Kyma installation triggered")
+	return nil
+}
+
+// WaitReady polls the Installation CR until it reports phase "Installed".
+func (b *installerBackend) WaitReady() error {
+	s := output.Step(b.cmd.Command, "Waiting for installation to complete")
+
+	client, err := kube.NewFromConfig("", b.cmd.opts.KubeconfigPath)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not initialize the Kubernetes client: %s", err)
+	}
+
+	if err := pollInstallationPhase(client, "Installed", s); err != nil {
+		s.Failure()
+		return err
+	}
+
+	s.Success("Kyma installed")
+	return nil
+}
+
+// Rollback deletes the Installation CR, undoing whatever Apply already triggered.
+func (b *installerBackend) Rollback() error {
+	if !b.applied {
+		return nil
+	}
+	s := output.Step(b.cmd.Command, "Rolling back installation")
+
+	client, err := kube.NewFromConfig("", b.cmd.opts.KubeconfigPath)
+	if err != nil {
+		s.Failure()
+		return fmt.Errorf("could not initialize the Kubernetes client: %s", err)
+	}
+
+	if err := client.Dynamic().Resource(installationGVR).Delete("kyma-installation", &metav1.DeleteOptions{}); err != nil {
+		s.Failure()
+		return fmt.Errorf("could not delete the Installation CR: %s", err)
+	}
+
+	s.Success("Installation CR removed")
+	return nil
+}
+
+// applyManifests creates or updates every object in data on the cluster.
+func applyManifests(client kube.KymaKube, data []map[string]interface{}) error {
+	for _, d := range data {
+		u := &unstructured.Unstructured{Object: d}
+		gvr, err := client.Dynamic().ResourceFor(u.GroupVersionKind())
+		if err != nil {
+			return fmt.Errorf("could not resolve resource for %s %q: %s", u.GetKind(), u.GetName(), err)
+		}
+		if _, err := client.Dynamic().Resource(gvr).Namespace(u.GetNamespace()).Create(u, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("could not apply %s %q: %s", u.GetKind(), u.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// setActionLabel sets the "action" label on the Installation CR, which the in-cluster installer
+// watches to decide whether to install or uninstall.
+func setActionLabel(client kube.KymaKube, action string) error {
+	u, err := client.Dynamic().Resource(installationGVR).Get("kyma-installation", metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("could not get the Installation CR: %s", err)
+	}
+	labels := u.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels["action"] = action
+	u.SetLabels(labels)
+	_, err = client.Dynamic().Resource(installationGVR).Update(u, metav1.UpdateOptions{})
+	return err
+}
+
+// pollInstallationPhase blocks until the Installation CR's status.state field equals phase.
+func pollInstallationPhase(client kube.KymaKube, phase string, s step.Step) error {
+	for {
+		u, err := client.Dynamic().Resource(installationGVR).Get("kyma-installation", metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("could not get the Installation CR: %s", err)
+		}
+		current, _, err := unstructured.NestedString(u.Object, "status", "state")
+		if err != nil {
+			return fmt.Errorf("could not read the Installation CR status: %s", err)
+		}
+		if current == phase {
+			return nil
+		}
+		if current == "Error" {
+			return fmt.Errorf("installation failed, run `kyma check` for diagnostics")
+		}
+		s.LogInfo(fmt.Sprintf("Installation is in phase %q", current))
+		time.Sleep(installationPollInterval)
+	}
+}
+
+// fetchManifests returns the raw Installation and installer Deployment manifests for source: a
+// local Kyma checkout is read straight off disk, anything else is treated as a released version
+// and downloaded from the kyma-project/kyma GitHub releases.
+func fetchManifests(source string) ([]byte, error) {
+	if isLocalSource(source) {
+		return ioutil.ReadFile(filepath.Join(source, "installation", "resources", "installer-cluster.yaml"))
+	}
+
+	url := fmt.Sprintf("https://github.com/kyma-project/kyma/releases/download/%s/kyma-installer-cluster.yaml", source)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s fetching %s", resp.Status, url)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// localInstallerImage returns the locally built installer image to deploy instead of the released
+// one, for a source that points at a local Kyma checkout.
+func localInstallerImage(source string) (string, bool) {
+	if !isLocalSource(source) {
+		return "", false
+	}
+	return "eu.gcr.io/kyma-project/kyma-installer:latest", true
+}
+
+// isLocalSource reports whether source is a path to a local Kyma checkout rather than a released
+// version, a PR number, or "latest".
+func isLocalSource(source string) bool {
+	info, err := os.Stat(source)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,20 @@
+package install
+
+import (
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+// Options defines available options for the install command.
+type Options struct {
+	*cli.Options
+	NoWait         bool
+	Source         string
+	Backend        string
+	ComponentsFile string
+	OverridesFile  string
+}
+
+// NewOptions creates options with default values.
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o, Backend: "installer"}
+}
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"github.com/kyma-project/cli/cmd/kyma/provision/provider"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+// New creates the AWS Provider and the bare cobra.Command describing it.
+// The returned command has no RunE: provision.NewCmd wires it to the shared run logic.
+func New(o *cli.Options) (provider.Provider, *cobra.Command) {
+	c := &command{
+		Command: cli.Command{Options: o},
+		opts:    NewOptions(o),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "aws",
+		Short: "Provisions an AWS cluster.",
+		Long:  `Use this command to provision an AWS cluster for Kyma installation.`,
+	}
+
+	return c, cmd
+}
@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+// Options defines available options for the provision aws command.
+type Options struct {
+	*cli.Options
+	Name              string
+	CredentialsFile   string
+	KubernetesVersion string
+	Region            string
+	Zone              string
+	MachineType       string
+	DiskSizeGB        int
+	NodeCount         int
+	VPCCIDR           string
+	PublicCIDR        string
+	InternalCIDR      string
+}
+
+// NewOptions creates options with default values.
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kyma-incubator/hydroform/types"
+	"github.com/spf13/cobra"
+)
+
+// Flags registers the AWS-specific flags on cmd.
+func (c *command) Flags(cmd *cobra.Command) {
+	o := c.opts
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the cluster to provision. (required)")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the AWS credentials file. (required)")
+	cmd.Flags().StringVarP(&o.KubernetesVersion, "kube-version", "k", "1.14", "Kubernetes version of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.Region, "region", "r", "eu-west-1", "Region of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.Zone, "zone", "z", "eu-west-1a", "Zone of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "m5.xlarge", "Type of machine of the cluster to provision.")
+	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Specifies the disk size in GB of the cluster to provision.")
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, "Specifies the number of nodes of the cluster to provision.")
+	cmd.Flags().StringVar(&o.VPCCIDR, "vpc-cidr", "10.250.0.0/19", "CIDR range for the VPC.")
+	cmd.Flags().StringVar(&o.PublicCIDR, "public-cidr", "10.250.96.0/22", "CIDR range for the public subnet.")
+	cmd.Flags().StringVar(&o.InternalCIDR, "internal-cidr", "10.250.112.0/22", "CIDR range for the internal subnet.")
+}
+
+// Validate checks that the mandatory AWS flags are set.
+func (c *command) Validate() error {
+	var errMessage strings.Builder
+	o := c.opts
+	if o.Name == "" {
+		errMessage.WriteString("\nRequired flag `name` has not been set.")
+	}
+	if o.CredentialsFile == "" {
+		errMessage.WriteString("\nRequired flag `credentials` has not been set.")
+	}
+
+	if errMessage.Len() != 0 {
+		return errors.New(errMessage.String())
+	}
+	return nil
+}
+
+// Cluster returns the hydroform cluster spec built from the AWS flags.
+func (c *command) Cluster() *types.Cluster {
+	o := c.opts
+	return &types.Cluster{
+		Name:              o.Name,
+		KubernetesVersion: o.KubernetesVersion,
+		DiskSizeGB:        o.DiskSizeGB,
+		NodeCount:         o.NodeCount,
+		Location:          o.Zone,
+		MachineType:       o.MachineType,
+	}
+}
+
+// Provider returns the hydroform provider spec built from the AWS flags.
+func (c *command) Provider() (*types.Provider, error) {
+	o := c.opts
+	return &types.Provider{
+		Type:                types.AWS,
+		CredentialsFilePath: o.CredentialsFile,
+		CustomConfigurations: map[string]interface{}{
+			"region":        o.Region,
+			"zone":          o.Zone,
+			"vpc_cidr":      o.VPCCIDR,
+			"public_cidr":   o.PublicCIDR,
+			"internal_cidr": o.InternalCIDR,
+		},
+	}, nil
+}
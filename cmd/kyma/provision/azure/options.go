@@ -0,0 +1,22 @@
+package azure
+
+import (
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+// Options defines available options for the provision azure command.
+type Options struct {
+	*cli.Options
+	Name              string
+	CredentialsFile   string
+	KubernetesVersion string
+	Location          string
+	MachineType       string
+	DiskSizeGB        int
+	NodeCount         int
+}
+
+// NewOptions creates options with default values.
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
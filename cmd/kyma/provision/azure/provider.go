@@ -0,0 +1,63 @@
+package azure
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kyma-incubator/hydroform/types"
+	"github.com/spf13/cobra"
+)
+
+// Flags registers the Azure-specific flags on cmd.
+func (c *command) Flags(cmd *cobra.Command) {
+	o := c.opts
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the cluster to provision. (required)")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the Azure service principal file. (required)")
+	cmd.Flags().StringVarP(&o.KubernetesVersion, "kube-version", "k", "1.14", "Kubernetes version of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.Location, "location", "l", "westeurope", "Location of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "Standard_D4_v3", "Type of machine of the cluster to provision.")
+	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Specifies the disk size in GB of the cluster to provision.")
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, "Specifies the number of nodes of the cluster to provision.")
+}
+
+// Validate checks that the mandatory Azure flags are set.
+func (c *command) Validate() error {
+	var errMessage strings.Builder
+	o := c.opts
+	if o.Name == "" {
+		errMessage.WriteString("\nRequired flag `name` has not been set.")
+	}
+	if o.CredentialsFile == "" {
+		errMessage.WriteString("\nRequired flag `credentials` has not been set.")
+	}
+
+	if errMessage.Len() != 0 {
+		return errors.New(errMessage.String())
+	}
+	return nil
+}
+
+// Cluster returns the hydroform cluster spec built from the Azure flags.
+func (c *command) Cluster() *types.Cluster {
+	o := c.opts
+	return &types.Cluster{
+		Name:              o.Name,
+		KubernetesVersion: o.KubernetesVersion,
+		DiskSizeGB:        o.DiskSizeGB,
+		NodeCount:         o.NodeCount,
+		Location:          o.Location,
+		MachineType:       o.MachineType,
+	}
+}
+
+// Provider returns the hydroform provider spec built from the Azure flags.
+func (c *command) Provider() (*types.Provider, error) {
+	o := c.opts
+	return &types.Provider{
+		Type:                types.Azure,
+		CredentialsFilePath: o.CredentialsFile,
+		CustomConfigurations: map[string]interface{}{
+			"location": o.Location,
+		},
+	}, nil
+}
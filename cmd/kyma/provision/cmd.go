@@ -0,0 +1,182 @@
+package provision
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	hf "github.com/kyma-incubator/hydroform"
+	"github.com/kyma-project/cli/cmd/kyma/provision/provider"
+	"github.com/kyma-project/cli/core/service/output"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/spf13/cobra"
+)
+
+// NewCmd creates a new provision command
+func NewCmd(o *cli.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provision",
+		Short: "Provisions a cluster for Kyma installation.",
+		Long:  `Use this command together with a subcommand to provision a cluster on one of the supported cloud providers.`,
+	}
+
+	// --output is a persistent flag on the root command, bound to cli.Options.Output.
+
+	for _, name := range Names() {
+		p, sub := registry[name](o)
+		p.Flags(sub)
+		sub.RunE = func(_ *cobra.Command, _ []string) error {
+			return run(o, p)
+		}
+		cmd.AddCommand(sub)
+	}
+
+	return cmd
+}
+
+// Run provisions a cluster named name on the named cloud provider, with the given Kubernetes
+// version, and appends its kubeconfig to o.KubeconfigPath. Credentials (and, where applicable,
+// the cloud project) are read from <PROVIDER>_CREDENTIALS_FILE and <PROVIDER>_PROJECT env vars,
+// following the same convention tests/acceptance uses for PROVIDER and KYMA_SOURCE. It is the
+// entry point for callers that already know which provider/cluster to provision without going
+// through a cobra command.
+func Run(o *cli.Options, providerName, name, kubernetesVersion string) error {
+	p, err := buildProvider(o, providerName, name, kubernetesVersion)
+	if err != nil {
+		return err
+	}
+	return run(o, p)
+}
+
+// Deprovision tears down the cluster named name on the named cloud provider, with the given
+// Kubernetes version. It takes the same arguments as Run so a caller (such as tests/acceptance)
+// can deprovision exactly the cluster an earlier Run call created.
+func Deprovision(o *cli.Options, providerName, name, kubernetesVersion string) error {
+	p, err := buildProvider(o, providerName, name, kubernetesVersion)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	cluster := p.Cluster()
+	prov, err := p.Provider()
+	if err != nil {
+		return err
+	}
+
+	cmd := cli.Command{Options: o}
+	return runStep(cmd, fmt.Sprintf("Deprovisioning %s cluster", prov.Type), func() error {
+		return hf.Deprovision(cluster, prov)
+	})
+}
+
+// buildProvider looks up providerName's Factory and configures it with name, kubernetesVersion
+// and the same <PROVIDER>_CREDENTIALS_FILE/<PROVIDER>_PROJECT env vars Run uses, without running
+// it - the shared setup behind both Run and Deprovision.
+func buildProvider(o *cli.Options, providerName, name, kubernetesVersion string) (provider.Provider, error) {
+	factory, ok := Get(providerName)
+	if !ok {
+		return nil, fmt.Errorf("unknown provisioning provider %q", providerName)
+	}
+
+	p, sub := factory(o)
+	p.Flags(sub)
+
+	envPrefix := strings.ToUpper(providerName)
+	flagValues := map[string]string{
+		"name":         name,
+		"kube-version": kubernetesVersion,
+		"credentials":  os.Getenv(envPrefix + "_CREDENTIALS_FILE"),
+		"project":      os.Getenv(envPrefix + "_PROJECT"),
+	}
+	for _, flagName := range []string{"name", "kube-version", "credentials", "project"} {
+		value := flagValues[flagName]
+		if value == "" {
+			continue
+		}
+		if f := sub.Flags().Lookup(flagName); f != nil {
+			if err := sub.Flags().Set(flagName, value); err != nil {
+				return nil, fmt.Errorf("could not set --%s: %s", flagName, err)
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// run drives every provider through the same provision -> save state -> append kubeconfig ->
+// print summary flow, so a new cloud only has to implement the Provider interface.
+func run(o *cli.Options, p provider.Provider) error {
+	format, err := output.ParseFormat(o.Output)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	cluster := p.Cluster()
+	prov, err := p.Provider()
+	if err != nil {
+		return err
+	}
+
+	if !o.Verbose {
+		// discard all the noise from terraform logs if not verbose
+		log.SetOutput(ioutil.Discard)
+	}
+
+	cmd := cli.Command{Options: o}
+
+	if err := runStep(cmd, fmt.Sprintf("Provisioning %s cluster", prov.Type), func() error {
+		cluster, err = hf.Provision(cluster, prov)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if err := runStep(cmd, "Saving cluster state", func() error {
+		return files.SaveClusterState(cluster, prov)
+	}); err != nil {
+		return err
+	}
+
+	if err := runStep(cmd, "Importing kubeconfig", func() error {
+		kubeconfig, err := hf.Credentials(cluster, prov)
+		if err != nil {
+			return err
+		}
+		return kube.AppendConfig(kubeconfig, o.KubeconfigPath)
+	}); err != nil {
+		return err
+	}
+
+	if format == output.Text {
+		fmt.Printf("\n%s cluster provisioned\nKubectl correctly configured: pointing to %s\n\nHappy %s-ing! :)\n", prov.Type, cluster.Name, prov.Type)
+		return nil
+	}
+	return output.EmitSummary(format, map[string]interface{}{
+		"provider":       string(prov.Type),
+		"clusterName":    cluster.Name,
+		"kubeconfigPath": o.KubeconfigPath,
+	})
+}
+
+// runStep executes fn as one named step, through output.Step so its progress is also emitted as
+// a structured output.StepEvent when cmd.Options.Output is not Text.
+func runStep(cmd cli.Command, name string, fn func() error) error {
+	s := output.Step(cmd, name)
+	if err := fn(); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+	return nil
+}
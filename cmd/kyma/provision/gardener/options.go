@@ -0,0 +1,25 @@
+package gardener
+
+import (
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+// Options defines available options for the provision gardener command.
+type Options struct {
+	*cli.Options
+	Name              string
+	Project           string
+	CredentialsFile   string
+	KubernetesVersion string
+	TargetProvider    string
+	TargetSecret      string
+	Region            string
+	MachineType       string
+	DiskSizeGB        int
+	NodeCount         int
+}
+
+// NewOptions creates options with default values.
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
@@ -0,0 +1,74 @@
+package gardener
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kyma-incubator/hydroform/types"
+	"github.com/spf13/cobra"
+)
+
+// Flags registers the Gardener-specific flags on cmd.
+func (c *command) Flags(cmd *cobra.Command) {
+	o := c.opts
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the cluster to provision. (required)")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Name of the Gardener project to provision the cluster in. (required)")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the Gardener kubeconfig. (required)")
+	cmd.Flags().StringVar(&o.TargetProvider, "target-provider", "gcp", "Target provider managed by Gardener. One of: gcp, aws, azure.")
+	cmd.Flags().StringVar(&o.TargetSecret, "target-secret", "", "Name of the Gardener secret holding the target provider credentials. (required)")
+	cmd.Flags().StringVarP(&o.KubernetesVersion, "kube-version", "k", "1.14", "Kubernetes version of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.Region, "region", "r", "europe-west3", "Region of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "n1-standard-4", "Type of machine of the cluster to provision.")
+	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Specifies the disk size in GB of the cluster to provision.")
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, "Specifies the number of nodes of the cluster to provision.")
+}
+
+// Validate checks that the mandatory Gardener flags are set.
+func (c *command) Validate() error {
+	var errMessage strings.Builder
+	o := c.opts
+	if o.Name == "" {
+		errMessage.WriteString("\nRequired flag `name` has not been set.")
+	}
+	if o.Project == "" {
+		errMessage.WriteString("\nRequired flag `project` has not been set.")
+	}
+	if o.CredentialsFile == "" {
+		errMessage.WriteString("\nRequired flag `credentials` has not been set.")
+	}
+	if o.TargetSecret == "" {
+		errMessage.WriteString("\nRequired flag `target-secret` has not been set.")
+	}
+
+	if errMessage.Len() != 0 {
+		return errors.New(errMessage.String())
+	}
+	return nil
+}
+
+// Cluster returns the hydroform cluster spec built from the Gardener flags.
+func (c *command) Cluster() *types.Cluster {
+	o := c.opts
+	return &types.Cluster{
+		Name:              o.Name,
+		KubernetesVersion: o.KubernetesVersion,
+		DiskSizeGB:        o.DiskSizeGB,
+		NodeCount:         o.NodeCount,
+		Location:          o.Region,
+		MachineType:       o.MachineType,
+	}
+}
+
+// Provider returns the hydroform provider spec built from the Gardener flags.
+func (c *command) Provider() (*types.Provider, error) {
+	o := c.opts
+	return &types.Provider{
+		Type:                types.Gardener,
+		ProjectName:         o.Project,
+		CredentialsFilePath: o.CredentialsFile,
+		CustomConfigurations: map[string]interface{}{
+			"target_provider": o.TargetProvider,
+			"target_secret":   o.TargetSecret,
+		},
+	}, nil
+}
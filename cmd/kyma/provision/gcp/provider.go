@@ -0,0 +1,79 @@
+package gcp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/hydroform/types"
+	"github.com/spf13/cobra"
+)
+
+// Flags registers the GCP-specific flags on cmd.
+func (c *command) Flags(cmd *cobra.Command) {
+	o := c.opts
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the cluster to provision. (required)")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Name of the GCP Project where to provision the cluster in. (required)")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the GCP service account key file. (required)")
+	cmd.Flags().StringVarP(&o.KubernetesVersion, "kube-version", "k", "1.14", "Kubernetes version of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.Location, "location", "l", "europe-west3-a", "Location of the cluster to provision.")
+	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "n1-standard-4", "Type of machine of the cluster to provision.")
+	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Specifies the disk size in GB of the cluster to provision.")
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, "Specifies the number of nodes of the cluster to provision.")
+	// Temporary disabled flag. To be enabled when hydroform supports TF modules
+	//cmd.Flags().StringSliceVarP(&o.Extra, "extra", "e", nil, "Provide one or more arguments of the form NAME=VALUE to add extra configurations.")
+}
+
+// Validate checks that the mandatory GCP flags are set.
+func (c *command) Validate() error {
+	var errMessage strings.Builder
+	o := c.opts
+	if o.Name == "" {
+		errMessage.WriteString("\nRequired flag `name` has not been set.")
+	}
+	if o.Project == "" {
+		errMessage.WriteString("\nRequired flag `project` has not been set.")
+	}
+	if o.CredentialsFile == "" {
+		errMessage.WriteString("\nRequired flag `credentials` has not been set.")
+	}
+
+	if errMessage.Len() != 0 {
+		return errors.New(errMessage.String())
+	}
+	return nil
+}
+
+// Cluster returns the hydroform cluster spec built from the GCP flags.
+func (c *command) Cluster() *types.Cluster {
+	o := c.opts
+	return &types.Cluster{
+		Name:              o.Name,
+		KubernetesVersion: o.KubernetesVersion,
+		DiskSizeGB:        o.DiskSizeGB,
+		NodeCount:         o.NodeCount,
+		Location:          o.Location,
+		MachineType:       o.MachineType,
+	}
+}
+
+// Provider returns the hydroform provider spec built from the GCP flags.
+func (c *command) Provider() (*types.Provider, error) {
+	o := c.opts
+	p := &types.Provider{
+		Type:                types.GCP,
+		ProjectName:         o.Project,
+		CredentialsFilePath: o.CredentialsFile,
+	}
+
+	p.CustomConfigurations = make(map[string]interface{})
+	for _, e := range o.Extra {
+		v := strings.Split(e, "=")
+
+		if len(v) != 2 {
+			return p, fmt.Errorf("Wrong format for extra configuration %s. Please provide NAME=VALUE pairs.", e)
+		}
+		p.CustomConfigurations[v[0]] = v[1]
+	}
+	return p, nil
+}
@@ -0,0 +1,25 @@
+// Package provider defines the interface every cloud provider subcommand of `kyma provision`
+// implements, so that adding a new cloud only requires a new implementation of it.
+package provider
+
+import (
+	"github.com/kyma-incubator/hydroform/types"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// Provider is implemented by every supported cloud provider subcommand.
+type Provider interface {
+	// Flags registers the provider-specific cobra flags.
+	Flags(cmd *cobra.Command)
+	// Validate checks that the flags required by the provider are set correctly.
+	Validate() error
+	// Cluster returns the hydroform cluster spec built from the provider's flags.
+	Cluster() *types.Cluster
+	// Provider returns the hydroform provider spec built from the provider's flags.
+	Provider() (*types.Provider, error)
+}
+
+// Factory creates a Provider together with the cobra.Command it is configured from.
+// The command returned has no RunE set: NewCmd wires it to the shared run logic.
+type Factory func(o *cli.Options) (Provider, *cobra.Command)
@@ -0,0 +1,38 @@
+package provision
+
+import (
+	"sort"
+
+	"github.com/kyma-project/cli/cmd/kyma/provision/aws"
+	"github.com/kyma-project/cli/cmd/kyma/provision/azure"
+	"github.com/kyma-project/cli/cmd/kyma/provision/gardener"
+	"github.com/kyma-project/cli/cmd/kyma/provision/gcp"
+	"github.com/kyma-project/cli/cmd/kyma/provision/provider"
+)
+
+// registry maps a provider name (used as the `kyma provision` subcommand name and as the
+// providerName argument of Run and Deprovision) to the Factory that builds it. `kyma install`
+// takes no provider name and does not provision a cluster. Adding a new cloud is a single
+// entry here plus a Provider implementation in its own package.
+var registry = map[string]provider.Factory{
+	"gcp":      gcp.New,
+	"aws":      aws.New,
+	"azure":    azure.New,
+	"gardener": gardener.New,
+}
+
+// Names returns the registered provider names in a stable, sorted order.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get looks up a registered provider Factory by name.
+func Get(name string) (provider.Factory, bool) {
+	f, ok := registry[name]
+	return f, ok
+}
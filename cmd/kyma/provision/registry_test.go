@@ -0,0 +1,22 @@
+package provision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Names(t *testing.T) {
+	require.Equal(t, []string{"aws", "azure", "gardener", "gcp"}, Names())
+}
+
+func Test_Get(t *testing.T) {
+	for _, name := range Names() {
+		f, ok := Get(name)
+		require.True(t, ok, name)
+		require.NotNil(t, f, name)
+	}
+
+	_, ok := Get("does-not-exist")
+	require.False(t, ok)
+}
@@ -0,0 +1,74 @@
+// Package assert provides cluster-state assertions shared by the acceptance test harness.
+package assert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/cli/internal/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var installationGVR = schema.GroupVersionResource{Group: "installer.kyma-project.io", Version: "v1alpha1", Resource: "installations"}
+
+// PodsReady waits until every pod in namespace is Running, or returns an error once timeout elapses.
+func PodsReady(client kube.KymaKube, namespace string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		pods, err := client.Static().CoreV1().Pods(namespace).List(metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("could not list pods in namespace %q: %s", namespace, err)
+		}
+
+		notReady := 0
+		for _, p := range pods.Items {
+			if p.Status.Phase != "Running" {
+				notReady++
+			}
+		}
+		if notReady == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%d pod(s) in namespace %q are not ready after %s", notReady, namespace, timeout)
+		}
+		time.Sleep(5 * time.Second)
+	}
+}
+
+// CRDPresent returns an error if the given CustomResourceDefinition does not exist.
+func CRDPresent(client kube.KymaKube, name string) error {
+	crdGVR := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	if _, err := client.Dynamic().Resource(crdGVR).Get(name, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("CRD %q is not present: %s", name, err)
+	}
+	return nil
+}
+
+// InstallationInstalled waits until the Installation CR reaches the "Installed" state.
+func InstallationInstalled(client kube.KymaKube, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		u, err := client.Dynamic().Resource(installationGVR).Get("kyma-installation", metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("could not get the Installation CR: %s", err)
+		}
+
+		state, _, err := unstructured.NestedString(u.Object, "status", "state")
+		if err != nil {
+			return fmt.Errorf("could not read Installation CR status: %s", err)
+		}
+		if state == "Installed" {
+			return nil
+		}
+		if state == "Error" {
+			return fmt.Errorf("installation CR reported state Error")
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("installation CR did not reach Installed after %s (last state: %q)", timeout, state)
+		}
+		time.Sleep(10 * time.Second)
+	}
+}
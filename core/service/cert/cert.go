@@ -0,0 +1,14 @@
+// Package cert provides small certificate helpers shared by the install and credentials commands.
+package cert
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Fingerprint returns the hex-encoded SHA-256 digest of crt, so a summary document can identify a
+// certificate without embedding its full PEM content.
+func Fingerprint(crt []byte) string {
+	sum := sha256.Sum256(crt)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,117 @@
+// Package output lets commands stay scriptable in CI: it renders step progress and final command
+// summaries either as human-readable prose (the default) or as structured JSON/YAML, so a caller
+// parsing stdout never has to scrape text like "Happy GCP-ing! :)".
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/step"
+	"sigs.k8s.io/yaml"
+)
+
+// Format is a supported --output value.
+type Format string
+
+const (
+	// Text keeps the existing human-readable prose and emits nothing else.
+	Text Format = "text"
+	// JSON emits one JSON object per step event, plus a final JSON summary, to stdout.
+	JSON Format = "json"
+	// YAML is the same as JSON, serialized as YAML instead.
+	YAML Format = "yaml"
+)
+
+// ParseFormat validates a --output flag value, defaulting an empty string to Text.
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case "", Text:
+		return Text, nil
+	case JSON:
+		return JSON, nil
+	case YAML:
+		return YAML, nil
+	default:
+		return "", fmt.Errorf("unknown output format %q, must be one of: text, json, yaml", s)
+	}
+}
+
+// StepEvent is one structured progress event. In JSON/YAML mode it is written to stdout while the
+// step's human-readable prose keeps going to stderr, so the two streams can be consumed separately.
+type StepEvent struct {
+	Step       string                 `json:"step" yaml:"step"`
+	Status     string                 `json:"status" yaml:"status"`
+	DurationMs int64                  `json:"duration_ms" yaml:"duration_ms"`
+	Data       map[string]interface{} `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+// Step creates a new step through cmd and, unless cmd's Options.Output is Text, decorates it so
+// its Success/Failure also emit a structured StepEvent. This is the one place a step and the
+// output sink are paired, so every command gets the same behavior from cmd.Options.Output alone
+// instead of re-implementing its own NewStep wrapper.
+func Step(cmd cli.Command, name string) step.Step {
+	format, _ := ParseFormat(cmd.Options.Output)
+	return WrapStep(cmd.NewStep(name), format, name)
+}
+
+// WrapStep decorates s so that its Success/Failure calls also emit a StepEvent named name in
+// format f. In Text mode s is returned unchanged.
+func WrapStep(s step.Step, f Format, name string) step.Step {
+	if f == Text || f == "" {
+		return s
+	}
+	return &emittingStep{Step: s, format: f, name: name, start: time.Now()}
+}
+
+type emittingStep struct {
+	step.Step
+	format Format
+	name   string
+	start  time.Time
+}
+
+func (s *emittingStep) Success(msg ...string) {
+	s.Step.Success(msg...)
+	emit(s.format, StepEvent{Step: s.name, Status: "success", DurationMs: time.Since(s.start).Milliseconds()})
+}
+
+func (s *emittingStep) Failure() {
+	s.Step.Failure()
+	emit(s.format, StepEvent{Step: s.name, Status: "failure", DurationMs: time.Since(s.start).Milliseconds()})
+}
+
+// EmitSummary writes the final command summary document to stdout in format f. It is a no-op in
+// Text mode, since the text summary is printed separately by the command itself.
+func EmitSummary(f Format, summary interface{}) error {
+	if f == Text || f == "" {
+		return nil
+	}
+	return write(f, summary)
+}
+
+func emit(f Format, event StepEvent) {
+	// Step events are best-effort: a marshaling failure here must not fail the command whose
+	// progress it is merely reporting on.
+	_ = write(f, event)
+}
+
+func write(f Format, v interface{}) error {
+	switch f {
+	case JSON:
+		enc := json.NewEncoder(os.Stdout)
+		return enc.Encode(v)
+	case YAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(os.Stdout, string(data))
+		return err
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+// +build acceptance
+
+package acceptance
+
+import (
+	"os"
+	"testing"
+)
+
+// TestAcceptance renders every scenario in ./scenarios with PROVIDER/KYMA_SOURCE, runs it
+// against a real cluster, and writes a JUnit report. Invoke it with `make acceptance`.
+func TestAcceptance(t *testing.T) {
+	provider := os.Getenv("PROVIDER")
+	if provider == "" {
+		t.Fatal("PROVIDER env var is required")
+	}
+	kymaSource := os.Getenv("KYMA_SOURCE")
+	if kymaSource == "" {
+		t.Fatal("KYMA_SOURCE env var is required")
+	}
+
+	scenarios, err := LoadScenarios("scenarios", templateVars{Provider: provider, KymaSource: kymaSource})
+	if err != nil {
+		t.Fatalf("could not load scenarios: %s", err)
+	}
+
+	kubeconfigPath := os.Getenv("KUBECONFIG")
+
+	results := make(map[string]error, len(scenarios))
+	for _, s := range scenarios {
+		if s.Provider != provider {
+			continue
+		}
+		t.Run(s.Name, func(t *testing.T) {
+			err := Run(s, kubeconfigPath)
+			results[s.Name] = err
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	if err := WriteJUnitReport("acceptance-report.xml", results); err != nil {
+		t.Fatalf("could not write JUnit report: %s", err)
+	}
+}
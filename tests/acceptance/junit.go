@@ -0,0 +1,45 @@
+// +build acceptance
+
+package acceptance
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+)
+
+// junitSuite is the minimal JUnit XML shape consumed by CI test reporters.
+type junitSuite struct {
+	XMLName  xml.Name    `xml:"testsuite"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name    string        `xml:"name,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnitReport writes a JUnit XML report summarizing the outcome of every scenario to path.
+func WriteJUnitReport(path string, results map[string]error) error {
+	suite := junitSuite{Name: "acceptance", Tests: len(results)}
+	for name, err := range results {
+		c := junitCase{Name: name}
+		if err != nil {
+			suite.Failures++
+			c.Failure = &junitFailure{Message: err.Error()}
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+
+	out, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, out, 0644)
+}
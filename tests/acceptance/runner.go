@@ -0,0 +1,85 @@
+// +build acceptance
+
+package acceptance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/cli/cmd/kyma/install"
+	"github.com/kyma-project/cli/cmd/kyma/provision"
+	"github.com/kyma-project/cli/core/service/assert"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+)
+
+// componentReadyTimeout bounds how long a single component is given to become ready.
+const componentReadyTimeout = 15 * time.Minute
+
+// Run provisions a cluster for s with the cmd/kyma/provision Provider, installs s.KymaSource onto
+// it with the cmd/kyma/install Backend, and asserts every expected component is healthy. If
+// s.Upgrade is set, it then installs s.Upgrade.UpgradeTo on the same cluster and re-asserts no
+// component regressed. On success, it tears the cluster back down; on failure, the cluster is
+// left in place for inspection.
+func Run(s Scenario, kubeconfigPath string) error {
+	o := cli.NewOptions()
+	o.KubeconfigPath = kubeconfigPath
+
+	if err := provision.Run(o, s.Provider, s.Name, s.KubernetesVersion); err != nil {
+		return fmt.Errorf("scenario %q: could not provision a cluster: %s", s.Name, err)
+	}
+
+	if err := runScenario(s, o, kubeconfigPath); err != nil {
+		return err
+	}
+
+	if err := provision.Deprovision(o, s.Provider, s.Name, s.KubernetesVersion); err != nil {
+		return fmt.Errorf("scenario %q: could not deprovision the cluster: %s", s.Name, err)
+	}
+	return nil
+}
+
+func runScenario(s Scenario, o *cli.Options, kubeconfigPath string) error {
+	client, err := kube.NewFromConfig("", kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("scenario %q: could not initialize the Kubernetes client: %s", s.Name, err)
+	}
+
+	if err := installKyma(o, s.KymaSource); err != nil {
+		return fmt.Errorf("scenario %q: could not install Kyma %s: %s", s.Name, s.KymaSource, err)
+	}
+	if err := assert.InstallationInstalled(client, componentReadyTimeout); err != nil {
+		return fmt.Errorf("scenario %q: initial installation did not become ready: %s", s.Name, err)
+	}
+	if err := assertComponents(s.Name, s.ExpectedComponents, client); err != nil {
+		return err
+	}
+
+	if s.Upgrade == nil {
+		return nil
+	}
+
+	if err := installKyma(o, s.Upgrade.UpgradeTo); err != nil {
+		return fmt.Errorf("scenario %q: could not upgrade to %s: %s", s.Name, s.Upgrade.UpgradeTo, err)
+	}
+	if err := assert.InstallationInstalled(client, componentReadyTimeout); err != nil {
+		return fmt.Errorf("scenario %q: upgrade to %s did not become ready: %s", s.Name, s.Upgrade.UpgradeTo, err)
+	}
+	return assertComponents(s.Name, s.ExpectedComponents, client)
+}
+
+// installKyma runs the install backend for source against the cluster at o.KubeconfigPath.
+func installKyma(o *cli.Options, source string) error {
+	io := install.NewOptions(o)
+	io.Source = source
+	return install.Run(io)
+}
+
+func assertComponents(scenario string, components []string, client kube.KymaKube) error {
+	for _, c := range components {
+		if err := assert.PodsReady(client, c, componentReadyTimeout); err != nil {
+			return fmt.Errorf("scenario %q: component %q is not ready: %s", scenario, c, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// +build acceptance
+
+package acceptance
+
+// Scenario describes one end-to-end provision+install flow to exercise.
+type Scenario struct {
+	Name               string   `yaml:"name"`
+	Provider           string   `yaml:"provider"`
+	KubernetesVersion  string   `yaml:"kubernetesVersion"`
+	KymaSource         string   `yaml:"kymaSource"`
+	ExpectedComponents []string `yaml:"expectedComponents"`
+	Upgrade            *Upgrade `yaml:"upgrade,omitempty"`
+}
+
+// Upgrade turns a Scenario into an upgrade test: install KymaSource, then upgrade to UpgradeTo
+// and assert that every component in ExpectedComponents is still healthy.
+type Upgrade struct {
+	UpgradeTo string `yaml:"upgradeTo"`
+}
@@ -0,0 +1,61 @@
+// +build acceptance
+
+package acceptance
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// templateVars are substituted into each scenario file before it is parsed, so a single YAML
+// file can be parameterized with PROVIDER/KYMA_SOURCE instead of one file per combination.
+type templateVars struct {
+	Provider   string
+	KymaSource string
+}
+
+// LoadScenarios renders every *.yaml file in dir with vars and unmarshals it into a Scenario.
+func LoadScenarios(dir string, vars templateVars) ([]Scenario, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("could not list scenario files in %q: %s", dir, err)
+	}
+
+	scenarios := make([]Scenario, 0, len(files))
+	for _, f := range files {
+		s, err := loadScenario(f, vars)
+		if err != nil {
+			return nil, fmt.Errorf("could not load scenario %q: %s", f, err)
+		}
+		scenarios = append(scenarios, s)
+	}
+	return scenarios, nil
+}
+
+func loadScenario(path string, vars templateVars) (Scenario, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Parse(string(raw))
+	if err != nil {
+		return Scenario{}, fmt.Errorf("could not parse template: %s", err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, vars); err != nil {
+		return Scenario{}, fmt.Errorf("could not render template: %s", err)
+	}
+
+	var s Scenario
+	if err := yaml.Unmarshal(rendered.Bytes(), &s); err != nil {
+		return Scenario{}, fmt.Errorf("could not unmarshal scenario: %s", err)
+	}
+	return s, nil
+}